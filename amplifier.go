@@ -0,0 +1,18 @@
+package main
+
+type Amplifier struct {
+	amplification float64
+	signal        SampleReader
+}
+
+func (a *Amplifier) Read() (Frame, error) {
+
+	sample, err := a.signal.Read()
+	if err != nil {
+		return Frame{}, err
+	}
+
+	sample.L = float32(float64(sample.L) * a.amplification)
+	sample.R = float32(float64(sample.R) * a.amplification)
+	return sample, nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import "errors"
+
+// Backend is an audio sink: something that can be opened with a given
+// format, written interleaved PCM bytes, and closed once done.
+type Backend interface {
+	Open(sampleRate, channels, bitDepth int) error
+	Write(p []byte) (int, error)
+	Close() error
+}
+
+// Render pumps sr through an Int16Encoder and writes the resulting PCM bytes
+// to dst until sr reports ErrEndOfSamples. dst must already be open.
+func Render(sr SampleReader, channels int, dst Backend) error {
+	encoded := NewInt16Encoder(sr, channels)
+	for {
+		buf, err := encoded.Read()
+		if errors.Is(err, ErrEndOfSamples) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := dst.Write(buf); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+// seekBuffer is a minimal in-memory io.WriteSeeker, since bytes.Buffer does
+// not implement Seek and WavBackend needs to patch its header after writing.
+type seekBuffer struct {
+	buf []byte
+	pos int
+}
+
+func (s *seekBuffer) Write(p []byte) (int, error) {
+	end := s.pos + len(p)
+	if end > len(s.buf) {
+		grown := make([]byte, end)
+		copy(grown, s.buf)
+		s.buf = grown
+	}
+	copy(s.buf[s.pos:end], p)
+	s.pos = end
+	return len(p), nil
+}
+
+func (s *seekBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		s.pos = int(offset)
+	case io.SeekCurrent:
+		s.pos += int(offset)
+	case io.SeekEnd:
+		s.pos = len(s.buf) + int(offset)
+	}
+	return int64(s.pos), nil
+}
+
+type fixedSignal struct {
+	frames []Frame
+	pos    int
+}
+
+func (f *fixedSignal) Read() (Frame, error) {
+	if f.pos >= len(f.frames) {
+		return Frame{}, ErrEndOfSamples
+	}
+	frame := f.frames[f.pos]
+	f.pos++
+	return frame, nil
+}
+
+func TestRenderToWavBackend(t *testing.T) {
+	const sampleRate, channels, bitDepth = 8000, 2, 2
+
+	signal := &fixedSignal{frames: []Frame{
+		{L: 1, R: -1},
+		{L: 0, R: 0},
+		{L: -1, R: 1},
+	}}
+
+	dst := &seekBuffer{}
+	backend := NewWavBackend(dst)
+	if err := backend.Open(sampleRate, channels, bitDepth); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if err := Render(signal, channels, backend); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if err := backend.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	data := []byte{
+		0xFF, 0x7F, 0x01, 0x80, // L=1, R=-1
+		0x00, 0x00, 0x00, 0x00, // L=0, R=0
+		0x01, 0x80, 0xFF, 0x7F, // L=-1, R=1
+	}
+
+	var want bytes.Buffer
+	want.WriteString("RIFF")
+	binary.Write(&want, binary.LittleEndian, uint32(36+len(data)))
+	want.WriteString("WAVE")
+	want.WriteString("fmt ")
+	binary.Write(&want, binary.LittleEndian, uint32(16))
+	binary.Write(&want, binary.LittleEndian, uint16(1)) // PCM
+	binary.Write(&want, binary.LittleEndian, uint16(channels))
+	binary.Write(&want, binary.LittleEndian, uint32(sampleRate))
+	binary.Write(&want, binary.LittleEndian, uint32(sampleRate*channels*bitDepth))
+	binary.Write(&want, binary.LittleEndian, uint16(channels*bitDepth))
+	binary.Write(&want, binary.LittleEndian, uint16(bitDepth*8))
+	want.WriteString("data")
+	binary.Write(&want, binary.LittleEndian, uint32(len(data)))
+	want.Write(data)
+
+	if !bytes.Equal(dst.buf, want.Bytes()) {
+		t.Fatalf("wav bytes mismatch\n got: % x\nwant: % x", dst.buf, want.Bytes())
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import "math"
+
+type BiquadType int
+
+const (
+	LowPass BiquadType = iota
+	HighPass
+	BandPass
+	Notch
+	Peak
+)
+
+type biquadState struct {
+	x1, x2, y1, y2 float32
+}
+
+// Biquad is a standard RBJ audio-EQ-cookbook biquad filter wrapping a
+// SampleReader, with independent state per stereo channel.
+type Biquad struct {
+	signal     SampleReader
+	sampleRate int
+	filterType BiquadType
+	cutoff     float64
+	q          float64
+	gainDB     float64
+
+	b0, b1, b2, a1, a2 float32
+	left, right        biquadState
+}
+
+func NewBiquad(signal SampleReader, sampleRate int, filterType BiquadType, cutoff, q float64) *Biquad {
+	b := &Biquad{
+		signal:     signal,
+		sampleRate: sampleRate,
+		filterType: filterType,
+		cutoff:     cutoff,
+		q:          q,
+	}
+	b.updateCoefficients()
+	return b
+}
+
+// SetParams changes the filter type/cutoff/Q, recomputing coefficients. Only
+// Peak uses gainDB; it is ignored by the other filter types.
+func (b *Biquad) SetParams(filterType BiquadType, cutoff, q, gainDB float64) {
+	b.filterType = filterType
+	b.cutoff = cutoff
+	b.q = q
+	b.gainDB = gainDB
+	b.updateCoefficients()
+}
+
+func (b *Biquad) updateCoefficients() {
+	w0 := 2 * math.Pi * b.cutoff / float64(b.sampleRate)
+	cosw := math.Cos(w0)
+	alpha := math.Sin(w0) / (2 * b.q)
+
+	var b0, b1, b2, a0, a1, a2 float64
+	switch b.filterType {
+	case HighPass:
+		b0 = (1 + cosw) / 2
+		b1 = -(1 + cosw)
+		b2 = (1 + cosw) / 2
+		a0 = 1 + alpha
+		a1 = -2 * cosw
+		a2 = 1 - alpha
+	case BandPass:
+		b0 = alpha
+		b1 = 0
+		b2 = -alpha
+		a0 = 1 + alpha
+		a1 = -2 * cosw
+		a2 = 1 - alpha
+	case Notch:
+		b0 = 1
+		b1 = -2 * cosw
+		b2 = 1
+		a0 = 1 + alpha
+		a1 = -2 * cosw
+		a2 = 1 - alpha
+	case Peak:
+		a := math.Pow(10, b.gainDB/40)
+		b0 = 1 + alpha*a
+		b1 = -2 * cosw
+		b2 = 1 - alpha*a
+		a0 = 1 + alpha/a
+		a1 = -2 * cosw
+		a2 = 1 - alpha/a
+	default: // LowPass
+		b0 = (1 - cosw) / 2
+		b1 = 1 - cosw
+		b2 = (1 - cosw) / 2
+		a0 = 1 + alpha
+		a1 = -2 * cosw
+		a2 = 1 - alpha
+	}
+
+	b.b0 = float32(b0 / a0)
+	b.b1 = float32(b1 / a0)
+	b.b2 = float32(b2 / a0)
+	b.a1 = float32(a1 / a0)
+	b.a2 = float32(a2 / a0)
+}
+
+func (b *Biquad) process(st *biquadState, x float32) float32 {
+	y := b.b0*x + b.b1*st.x1 + b.b2*st.x2 - b.a1*st.y1 - b.a2*st.y2
+	st.x2, st.x1 = st.x1, x
+	st.y2, st.y1 = st.y1, y
+	return y
+}
+
+func (b *Biquad) Read() (Frame, error) {
+	s, err := b.signal.Read()
+	if err != nil {
+		return Frame{}, err
+	}
+
+	return Frame{
+		L: b.process(&b.left, s.L),
+		R: b.process(&b.right, s.R),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package main
+
+type Echo struct {
+	signal        SampleReader
+	pos           int
+	buf           []Frame
+	amplification float64
+}
+
+func (e *Echo) Read() (Frame, error) {
+	s, err := e.signal.Read()
+	if err != nil {
+		return Frame{}, err
+	}
+
+	pos := e.pos % len(e.buf)
+	e.pos++
+
+	old := e.buf[pos]
+	e.buf[pos] = s
+
+	s.L = clamp1(s.L + float32(float64(old.L)*e.amplification))
+	s.R = clamp1(s.R + float32(float64(old.R)*e.amplification))
+	return s, nil
+}
@@ -0,0 +1,38 @@
+package main
+
+import "encoding/binary"
+
+// Int16Encoder converts a float32 SampleReader into little-endian, signed
+// 16-bit PCM bytes suitable for writing to an audio backend. Out-of-range
+// samples are clamped rather than wrapped.
+type Int16Encoder struct {
+	signal   SampleReader
+	channels int
+}
+
+func NewInt16Encoder(signal SampleReader, channels int) *Int16Encoder {
+	return &Int16Encoder{signal: signal, channels: channels}
+}
+
+func (e *Int16Encoder) Read() ([]byte, error) {
+	frame, err := e.signal.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if e.channels == 1 {
+		buf := make([]byte, 2)
+		binary.LittleEndian.PutUint16(buf, encodeSample(frame.L))
+		return buf, nil
+	}
+
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint16(buf[0:2], encodeSample(frame.L))
+	binary.LittleEndian.PutUint16(buf[2:4], encodeSample(frame.R))
+	return buf, nil
+}
+
+func encodeSample(s float32) uint16 {
+	s = clamp1(s)
+	return uint16(int16(s * 0x7FFF))
+}
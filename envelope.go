@@ -0,0 +1,98 @@
+package main
+
+type envelopePhase int
+
+const (
+	phaseAttack envelopePhase = iota
+	phaseDecay
+	phaseSustain
+	phaseRelease
+	phaseDone
+)
+
+// Envelope wraps a SampleReader and shapes its amplitude over time following
+// a classic attack/decay/sustain/release contour. Attack, decay, release and
+// duration are all expressed in samples.
+type Envelope struct {
+	signal   SampleReader
+	attack   int
+	decay    int
+	release  int
+	sustain  float32
+	duration int
+
+	pos        int
+	releasePos int
+	phase      envelopePhase
+}
+
+func NewEnvelope(signal SampleReader, attack, decay, release int, sustain float32, duration int) *Envelope {
+	return &Envelope{
+		signal:   signal,
+		attack:   attack,
+		decay:    decay,
+		release:  release,
+		sustain:  sustain,
+		duration: duration,
+		phase:    phaseAttack,
+	}
+}
+
+// Gate releases the note early, forcing an immediate transition into the
+// release phase regardless of the configured duration.
+func (e *Envelope) Gate(on bool) {
+	if !on && e.phase != phaseRelease && e.phase != phaseDone {
+		e.phase = phaseRelease
+		e.releasePos = e.pos
+	}
+}
+
+func (e *Envelope) Read() (Frame, error) {
+	if e.phase == phaseDone {
+		return Frame{}, ErrEndOfSamples
+	}
+
+	s, err := e.signal.Read()
+	if err != nil {
+		return Frame{}, err
+	}
+
+	if e.phase != phaseRelease && e.pos >= e.attack+e.decay+e.duration {
+		e.Gate(false)
+	}
+
+	var amp float32
+	switch {
+	case e.phase == phaseRelease:
+		if e.release <= 0 {
+			e.phase = phaseDone
+			amp = 0
+		} else {
+			t := float32(e.pos-e.releasePos) / float32(e.release)
+			if t >= 1 {
+				e.phase = phaseDone
+				amp = 0
+			} else {
+				amp = e.sustain * (1 - t)
+			}
+		}
+	case e.pos < e.attack:
+		if e.attack <= 0 {
+			amp = 1
+		} else {
+			amp = float32(e.pos) / float32(e.attack)
+		}
+	case e.pos < e.attack+e.decay:
+		if e.decay <= 0 {
+			amp = e.sustain
+		} else {
+			t := float32(e.pos-e.attack) / float32(e.decay)
+			amp = 1 - t*(1-e.sustain)
+		}
+	default:
+		amp = e.sustain
+	}
+
+	e.pos++
+	return Frame{L: s.L * amp, R: s.R * amp}, nil
+}
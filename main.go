@@ -1,10 +1,6 @@
 package main
 
 import (
-	"encoding/binary"
-	"errors"
-	"fmt"
-	"github.com/hajimehoshi/oto"
 	"sync"
 )
 
@@ -15,9 +11,6 @@ const (
 )
 
 func main() {
-	// Prepare an Oto context (this will use your default audio device) that will
-	// play all our sounds. Its configuration can't be changed later.
-
 	// Usually 44100 or 48000. Other values might cause distortions in Oto
 	samplingRate := 48000
 
@@ -28,18 +21,11 @@ func main() {
 	// Bytes used by a channel to represent one sample. Either 1 or 2 (usually 2).
 	audioBitDepth := 2
 
-	// Buffer size
-	bufferSize := 1024
-
-	// Remember that you should **not** create more than one context
-	otoCtx, err := oto.NewContext(samplingRate, numOfChannels, audioBitDepth, bufferSize)
-	if err != nil {
+	backend := &OtoBackend{}
+	if err := backend.Open(samplingRate, numOfChannels, audioBitDepth); err != nil {
 		panic("Failed to create oto context")
 	}
-
-	// Create a new 'player' that will handle our sound. Paused by default.
-	player := otoCtx.NewPlayer()
-	defer player.Close()
+	defer backend.Close()
 
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -56,7 +42,7 @@ func main() {
 		}
 		echo := &Echo{
 			signal:        saw2,
-			buf:           make([]uint16, 3939),
+			buf:           make([]Frame, 3939),
 			amplification: 0.5,
 		}
 		square := &SquareWave{
@@ -87,150 +73,10 @@ func main() {
 				}}},
 		}
 
-		buf := make([]byte, 2)
-		for {
-			sample, err := wave.Read()
-			if errors.Is(err, ErrEndOfSamples) {
-				return
-			}
-			if err != nil {
-				panic(err)
-			}
-			binary.LittleEndian.PutUint16(buf, sample)
-			_, err = player.Write(buf)
-			if err != nil {
-				panic(err)
-			}
+		if err := Render(wave, numOfChannels, backend); err != nil {
+			panic(err)
 		}
 	}()
 
 	wg.Wait()
 }
-
-var ErrEndOfSamples = fmt.Errorf("end of samples")
-
-type SampleReader interface {
-	Read() (uint16, error)
-}
-
-type Echo struct {
-	signal        SampleReader
-	pos           int
-	buf           []uint16
-	amplification float64
-}
-
-func (e *Echo) Read() (uint16, error) {
-	s, err := e.signal.Read()
-	if err != nil {
-		return 0, err
-	}
-
-	pos := e.pos % len(e.buf)
-	e.pos++
-
-	old := e.buf[pos]
-	e.buf[pos] = s
-
-	old = uint16(float64(old) * e.amplification)
-	return s + old, nil
-}
-
-type Sequence struct {
-	sequences []SampleReader
-	pos       int
-}
-
-func (s *Sequence) Read() (uint16, error) {
-	if s.pos == len(s.sequences) {
-		return 0, ErrEndOfSamples
-	}
-
-	seq := s.sequences[s.pos]
-
-	sample, err := seq.Read()
-	for errors.Is(err, ErrEndOfSamples) {
-		s.pos++
-		return s.Read()
-	}
-	return sample, nil
-}
-
-type Mixer struct {
-	signals []SampleReader
-}
-
-func (m *Mixer) Read() (uint16, error) {
-
-	var sample uint16
-	var anySignal bool
-	for _, signal := range m.signals {
-		s, err := signal.Read()
-		if err == ErrEndOfSamples {
-			continue
-		}
-		sample += s
-		anySignal = true
-	}
-	if !anySignal {
-		return 0, ErrEndOfSamples
-	}
-
-	return sample, nil
-}
-
-type Amplifier struct {
-	amplification float64
-	signal        SampleReader
-}
-
-func (a *Amplifier) Read() (uint16, error) {
-
-	sample, err := a.signal.Read()
-	if err != nil {
-		return 0, err
-	}
-
-	sample = uint16(float64(sample) * a.amplification)
-	return sample, nil
-}
-
-type SawToothWave struct {
-	pos    int
-	length int
-	period int
-}
-
-func (s *SawToothWave) Read() (uint16, error) {
-
-	if s.pos == s.length {
-		return 0, ErrEndOfSamples
-	}
-
-	t := float64(s.pos%s.period) / float64(s.period)
-	sample := uint16(0xFFFF * t)
-
-	s.pos++
-	return sample, nil
-}
-
-type SquareWave struct {
-	pos    int
-	length int
-	period int
-}
-
-func (s *SquareWave) Read() (uint16, error) {
-	if s.pos == s.length {
-		return 0, ErrEndOfSamples
-	}
-
-	on := (s.pos/s.period)%2 == 0
-	s.pos++
-
-	if on {
-		return 0xFFFF, nil
-	} else {
-		return 0, nil
-	}
-}
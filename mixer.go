@@ -0,0 +1,30 @@
+package main
+
+// Mixer sums the frames of multiple signals, saturating the result so that
+// combining several loud sources clips instead of wrapping around.
+type Mixer struct {
+	signals []SampleReader
+}
+
+func (m *Mixer) Read() (Frame, error) {
+
+	var sample Frame
+	var anySignal bool
+	for _, signal := range m.signals {
+		s, err := signal.Read()
+		if err == ErrEndOfSamples {
+			continue
+		}
+		sample.L += s.L
+		sample.R += s.R
+		anySignal = true
+	}
+	if !anySignal {
+		return Frame{}, ErrEndOfSamples
+	}
+
+	sample.L = clamp1(sample.L)
+	sample.R = clamp1(sample.R)
+
+	return sample, nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// NewMp3Decoder decodes an MP3 stream from r and returns a SampleReader
+// resampled to match contextSampleRate.
+func NewMp3Decoder(r io.Reader, contextSampleRate int) (SampleReader, error) {
+	dec, err := mp3.NewDecoder(r)
+	if err != nil {
+		return nil, fmt.Errorf("mp3: %w", err)
+	}
+
+	raw := &pcm16Reader{r: dec, channels: 2}
+	return NewResampler(raw, dec.SampleRate(), contextSampleRate), nil
+}
+
+// pcm16Reader reads interleaved little-endian 16-bit PCM, as produced by
+// go-mp3's decoder.
+type pcm16Reader struct {
+	r        io.Reader
+	channels int
+}
+
+func (p *pcm16Reader) Read() (Frame, error) {
+	buf := make([]byte, 2*p.channels)
+	if _, err := io.ReadFull(p.r, buf); err != nil {
+		return Frame{}, wavEOF(err)
+	}
+
+	l := int16(uint16(buf[0]) | uint16(buf[1])<<8)
+	if p.channels == 1 {
+		s := float32(l) / 0x8000
+		return Frame{L: s, R: s}, nil
+	}
+
+	r := int16(uint16(buf[2]) | uint16(buf[3])<<8)
+	return Frame{L: float32(l) / 0x8000, R: float32(r) / 0x8000}, nil
+}
@@ -0,0 +1,15 @@
+package main
+
+import "time"
+
+// Note describes a single pitch to be played for a given duration at a given
+// volume (0.0-1.0).
+type Note struct {
+	Freq     float64
+	Duration time.Duration
+	Volume   float64
+}
+
+func durationToSamples(d time.Duration, sampleRate int) int {
+	return int(d.Seconds()*float64(sampleRate) + 0.5)
+}
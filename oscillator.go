@@ -0,0 +1,47 @@
+package main
+
+type SawToothWave struct {
+	pos    int
+	length int
+	period int
+}
+
+func NewSawToothWave(freq float64, sampleRate, length int) SampleReader {
+	return &SawToothWave{length: length, period: int(float64(sampleRate)/freq + 0.5)}
+}
+
+func (s *SawToothWave) Read() (Frame, error) {
+	if s.pos == s.length {
+		return Frame{}, ErrEndOfSamples
+	}
+
+	t := float32(s.pos%s.period) / float32(s.period)
+	sample := 2*t - 1
+
+	s.pos++
+	return Frame{L: sample, R: sample}, nil
+}
+
+type SquareWave struct {
+	pos    int
+	length int
+	period int
+}
+
+func NewSquareWave(freq float64, sampleRate, length int) SampleReader {
+	return &SquareWave{length: length, period: int(float64(sampleRate)/freq + 0.5)}
+}
+
+func (s *SquareWave) Read() (Frame, error) {
+	if s.pos == s.length {
+		return Frame{}, ErrEndOfSamples
+	}
+
+	on := (s.pos/s.period)%2 == 0
+	s.pos++
+
+	if on {
+		return Frame{L: 1, R: 1}, nil
+	}
+	return Frame{L: -1, R: -1}, nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import "github.com/hajimehoshi/oto"
+
+// OtoBackend plays audio through the default system output device via oto.
+type OtoBackend struct {
+	ctx    *oto.Context
+	player *oto.Player
+}
+
+func (b *OtoBackend) Open(sampleRate, channels, bitDepth int) error {
+	ctx, err := oto.NewContext(sampleRate, channels, bitDepth, 1024)
+	if err != nil {
+		return err
+	}
+	b.ctx = ctx
+	b.player = ctx.NewPlayer()
+	return nil
+}
+
+func (b *OtoBackend) Write(p []byte) (int, error) {
+	return b.player.Write(p)
+}
+
+func (b *OtoBackend) Close() error {
+	return b.player.Close()
+}
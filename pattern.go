@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PatternSpec is the JSON description of a sequencer pattern: a tempo, a
+// tick resolution and a list of tracks, each with a step string such as
+// "x...x...x...x...".
+type PatternSpec struct {
+	BPM          int         `json:"bpm"`
+	TicksPerBeat int         `json:"ticksPerBeat"`
+	Loop         int         `json:"loop"`
+	Tracks       []TrackSpec `json:"tracks"`
+}
+
+type TrackSpec struct {
+	Instrument string  `json:"instrument"`
+	Steps      string  `json:"steps"`
+	Freq       float64 `json:"freq"`
+	Duration   string  `json:"duration"`
+	Volume     float64 `json:"volume"`
+}
+
+func ParsePattern(data []byte) (*PatternSpec, error) {
+	var spec PatternSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("parse pattern: %w", err)
+	}
+
+	if spec.BPM <= 0 {
+		return nil, fmt.Errorf("parse pattern: bpm must be positive")
+	}
+	if spec.TicksPerBeat <= 0 {
+		return nil, fmt.Errorf("parse pattern: ticksPerBeat must be positive")
+	}
+
+	for i, t := range spec.Tracks {
+		if _, err := time.ParseDuration(t.Duration); err != nil {
+			return nil, fmt.Errorf("parse pattern: track %d: %w", i, err)
+		}
+	}
+
+	return &spec, nil
+}
@@ -0,0 +1,73 @@
+//go:build portaudio
+
+package main
+
+import "github.com/gordonklaus/portaudio"
+
+// portaudioFramesPerBuffer is the fixed buffer size (in frames) bound to the
+// stream at Open time, matching the blocking-mode examples shipped with
+// gordonklaus/portaudio.
+const portaudioFramesPerBuffer = 1024
+
+// PortaudioBackend plays audio via PortAudio. It is built behind the
+// "portaudio" build tag so that the default build does not require cgo or
+// the PortAudio native library to be installed.
+type PortaudioBackend struct {
+	stream   *portaudio.Stream
+	channels int
+	buf      []int16
+}
+
+func (b *PortaudioBackend) Open(sampleRate, channels, bitDepth int) error {
+	if err := portaudio.Initialize(); err != nil {
+		return err
+	}
+
+	b.channels = channels
+	b.buf = make([]int16, portaudioFramesPerBuffer*channels)
+	stream, err := portaudio.OpenDefaultStream(0, channels, float64(sampleRate), portaudioFramesPerBuffer, &b.buf)
+	if err != nil {
+		return err
+	}
+	b.stream = stream
+
+	return b.stream.Start()
+}
+
+// Write decodes p into fixed-size buf chunks and writes each full buffer to
+// the stream, zero-padding (silence) the final partial chunk if p doesn't
+// divide evenly.
+func (b *PortaudioBackend) Write(p []byte) (int, error) {
+	bytesPerBuf := len(b.buf) * 2
+
+	written := 0
+	for written < len(p) {
+		n := bytesPerBuf
+		if remaining := len(p) - written; remaining < n {
+			n = remaining
+		}
+
+		for i := range b.buf {
+			off := written + i*2
+			if off+1 < written+n {
+				b.buf[i] = int16(uint16(p[off]) | uint16(p[off+1])<<8)
+			} else {
+				b.buf[i] = 0
+			}
+		}
+
+		if err := b.stream.Write(); err != nil {
+			return written, err
+		}
+		written += n
+	}
+
+	return written, nil
+}
+
+func (b *PortaudioBackend) Close() error {
+	if err := b.stream.Close(); err != nil {
+		return err
+	}
+	return portaudio.Terminate()
+}
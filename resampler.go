@@ -0,0 +1,64 @@
+package main
+
+// Resampler linearly interpolates signal from srcRate to dstRate, so that
+// decoded files recorded at a different rate than the playback context
+// still play back at the correct pitch and speed.
+type Resampler struct {
+	signal SampleReader
+	ratio  float64
+	srcPos float64
+
+	x0, x1  Frame
+	primed  bool
+	drained bool // true once the source has no more samples beyond x1
+}
+
+func NewResampler(signal SampleReader, srcRate, dstRate int) *Resampler {
+	return &Resampler{signal: signal, ratio: float64(srcRate) / float64(dstRate)}
+}
+
+func (r *Resampler) Read() (Frame, error) {
+	if r.ratio == 1 {
+		return r.signal.Read()
+	}
+
+	if !r.primed {
+		x0, err := r.signal.Read()
+		if err != nil {
+			return Frame{}, err
+		}
+		// A source with only a single sample (e.g. a very short one-shot
+		// drum hit) has nothing to interpolate towards; hold x0 instead of
+		// aborting so that sample still gets played.
+		x1, err := r.signal.Read()
+		if err != nil {
+			x1 = x0
+			r.drained = true
+		}
+		r.x0, r.x1 = x0, x1
+		r.primed = true
+	}
+
+	for r.srcPos >= 1 {
+		if r.drained {
+			return Frame{}, ErrEndOfSamples
+		}
+		r.x0 = r.x1
+		x1, err := r.signal.Read()
+		if err != nil {
+			r.x1 = r.x0
+			r.drained = true
+			break
+		}
+		r.x1 = x1
+		r.srcPos -= 1
+	}
+
+	t := float32(r.srcPos)
+	frame := Frame{
+		L: r.x0.L + (r.x1.L-r.x0.L)*t,
+		R: r.x0.R + (r.x1.R-r.x0.R)*t,
+	}
+	r.srcPos += r.ratio
+	return frame, nil
+}
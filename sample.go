@@ -0,0 +1,26 @@
+package main
+
+import "fmt"
+
+var ErrEndOfSamples = fmt.Errorf("end of samples")
+
+// Frame is a single stereo sample pair in the range [-1.0, 1.0].
+type Frame struct {
+	L, R float32
+}
+
+// SampleReader produces a stream of audio frames, returning ErrEndOfSamples
+// once the stream is exhausted.
+type SampleReader interface {
+	Read() (Frame, error)
+}
+
+func clamp1(v float32) float32 {
+	if v > 1 {
+		return 1
+	}
+	if v < -1 {
+		return -1
+	}
+	return v
+}
@@ -0,0 +1,25 @@
+package main
+
+import "errors"
+
+// Sequence plays through a list of signals one after another, advancing to
+// the next once the current one reports ErrEndOfSamples.
+type Sequence struct {
+	sequences []SampleReader
+	pos       int
+}
+
+func (s *Sequence) Read() (Frame, error) {
+	if s.pos == len(s.sequences) {
+		return Frame{}, ErrEndOfSamples
+	}
+
+	seq := s.sequences[s.pos]
+
+	sample, err := seq.Read()
+	for errors.Is(err, ErrEndOfSamples) {
+		s.pos++
+		return s.Read()
+	}
+	return sample, nil
+}
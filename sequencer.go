@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Track pairs a Voice instrument with the step pattern that triggers it,
+// e.g. "x...x...x...x..." fires the instrument's Note on every 4th step.
+type Track struct {
+	Instrument *Voice
+	Note       Note
+	Steps      string
+}
+
+// Sequencer drives a set of Tracks from a BPM/ticks-per-beat grid, triggering
+// a fresh instrument voice whenever a step boundary is crossed and the step
+// is active, and mixing all tracks' output together.
+type Sequencer struct {
+	ticksPerBeat   int
+	samplesPerStep int
+	numSteps       int
+	loop           int
+
+	tracks []Track
+
+	pos       int
+	loopCount int
+}
+
+func NewSequencer(sampleRate, bpm, ticksPerBeat int, tracks []Track, loop int) *Sequencer {
+	numSteps := 0
+	for _, t := range tracks {
+		if len(t.Steps) > numSteps {
+			numSteps = len(t.Steps)
+		}
+	}
+
+	return &Sequencer{
+		ticksPerBeat:   ticksPerBeat,
+		samplesPerStep: sampleRate * 60 / (bpm * ticksPerBeat),
+		numSteps:       numSteps,
+		loop:           loop,
+		tracks:         tracks,
+	}
+}
+
+// NewSequencerFromSpec builds a Sequencer from a parsed PatternSpec, looking
+// up each track's instrument by name in instruments.
+func NewSequencerFromSpec(spec *PatternSpec, sampleRate int, instruments map[string]*Voice) (*Sequencer, error) {
+	tracks := make([]Track, 0, len(spec.Tracks))
+	for _, t := range spec.Tracks {
+		instrument, ok := instruments[t.Instrument]
+		if !ok {
+			return nil, fmt.Errorf("sequencer: unknown instrument %q", t.Instrument)
+		}
+
+		duration, err := time.ParseDuration(t.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("sequencer: track %q: %w", t.Instrument, err)
+		}
+
+		tracks = append(tracks, Track{
+			Instrument: instrument,
+			Note:       Note{Freq: t.Freq, Duration: duration, Volume: t.Volume},
+			Steps:      t.Steps,
+		})
+	}
+
+	return NewSequencer(sampleRate, spec.BPM, spec.TicksPerBeat, tracks, spec.Loop), nil
+}
+
+func (s *Sequencer) step() int {
+	return s.pos / s.samplesPerStep
+}
+
+// Beat returns the current beat within the bar, counting from 0.
+func (s *Sequencer) Beat() int {
+	return (s.step() / s.ticksPerBeat) % 4
+}
+
+// Bar returns the current 4/4 bar number, counting from 0.
+func (s *Sequencer) Bar() int {
+	return s.step() / (s.ticksPerBeat * 4)
+}
+
+func (s *Sequencer) Read() (Frame, error) {
+	if s.loop > 0 && s.loopCount >= s.loop {
+		return Frame{}, ErrEndOfSamples
+	}
+
+	if s.pos%s.samplesPerStep == 0 {
+		step := s.step()
+		for i := range s.tracks {
+			t := &s.tracks[i]
+			if step < len(t.Steps) && t.Steps[step] == 'x' {
+				t.Instrument.NoteOn(t.Note)
+			}
+		}
+	}
+
+	var sample Frame
+	for i := range s.tracks {
+		f, err := s.tracks[i].Instrument.Read()
+		if err != nil {
+			continue
+		}
+		sample.L += f.L
+		sample.R += f.R
+	}
+	sample.L = clamp1(sample.L)
+	sample.R = clamp1(sample.R)
+
+	s.pos++
+	if s.numSteps > 0 && s.pos >= s.numSteps*s.samplesPerStep {
+		s.pos = 0
+		s.loopCount++
+	}
+
+	return sample, nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import "errors"
+
+// Synth consumes a stream of notes and turns them into audio by driving a
+// single Voice, advancing to the next note once the current one finishes.
+type Synth struct {
+	voice *Voice
+	notes []Note
+	pos   int
+}
+
+func NewSynth(voice *Voice, notes []Note) *Synth {
+	s := &Synth{voice: voice, notes: notes}
+	if len(notes) > 0 {
+		voice.NoteOn(notes[0])
+	}
+	return s
+}
+
+func (s *Synth) Read() (Frame, error) {
+	if s.pos >= len(s.notes) {
+		return Frame{}, ErrEndOfSamples
+	}
+
+	sample, err := s.voice.Read()
+	if errors.Is(err, ErrEndOfSamples) {
+		s.pos++
+		if s.pos >= len(s.notes) {
+			return Frame{}, ErrEndOfSamples
+		}
+		s.voice.NoteOn(s.notes[s.pos])
+		return s.Read()
+	}
+	return sample, err
+}
@@ -0,0 +1,59 @@
+package main
+
+import "time"
+
+// Oscillator builds a SampleReader for a single note at the given
+// frequency, producing at most length samples.
+type Oscillator func(freq float64, sampleRate, length int) SampleReader
+
+// Voice instantiates an oscillator and wraps it in an Envelope for each Note
+// it is asked to play.
+type Voice struct {
+	SampleRate int
+	Oscillator Oscillator
+	Attack     time.Duration
+	Decay      time.Duration
+	Release    time.Duration
+	Sustain    float32
+
+	env *Envelope
+}
+
+func NewVoice(sampleRate int, oscillator Oscillator, attack, decay, release time.Duration, sustain float32) *Voice {
+	return &Voice{
+		SampleRate: sampleRate,
+		Oscillator: oscillator,
+		Attack:     attack,
+		Decay:      decay,
+		Release:    release,
+		Sustain:    sustain,
+	}
+}
+
+// NoteOn starts playing n, replacing whatever note the voice was previously
+// playing.
+func (v *Voice) NoteOn(n Note) {
+	attack := durationToSamples(v.Attack, v.SampleRate)
+	decay := durationToSamples(v.Decay, v.SampleRate)
+	release := durationToSamples(v.Release, v.SampleRate)
+	duration := durationToSamples(n.Duration, v.SampleRate)
+
+	osc := v.Oscillator(n.Freq, v.SampleRate, attack+decay+duration+release)
+	amp := &Amplifier{amplification: n.Volume, signal: osc}
+
+	v.env = NewEnvelope(amp, attack, decay, release, v.Sustain, duration)
+}
+
+// Gate(false) releases the currently playing note early.
+func (v *Voice) Gate(on bool) {
+	if v.env != nil {
+		v.env.Gate(on)
+	}
+}
+
+func (v *Voice) Read() (Frame, error) {
+	if v.env == nil {
+		return Frame{}, ErrEndOfSamples
+	}
+	return v.env.Read()
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jfreymuth/oggvorbis"
+)
+
+// NewVorbisDecoder decodes an Ogg Vorbis stream from r and returns a
+// SampleReader resampled to match contextSampleRate.
+func NewVorbisDecoder(r io.Reader, contextSampleRate int) (SampleReader, error) {
+	dec, err := oggvorbis.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("vorbis: %w", err)
+	}
+
+	raw := &vorbisReader{dec: dec, channels: dec.Channels()}
+	return NewResampler(raw, dec.SampleRate(), contextSampleRate), nil
+}
+
+type vorbisReader struct {
+	dec      *oggvorbis.Reader
+	channels int
+	buf      []float32
+}
+
+func (v *vorbisReader) Read() (Frame, error) {
+	if v.buf == nil {
+		v.buf = make([]float32, v.channels)
+	}
+
+	n, err := v.dec.Read(v.buf)
+	if n < v.channels {
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+		return Frame{}, wavEOF(err)
+	}
+
+	if v.channels == 1 {
+		return Frame{L: v.buf[0], R: v.buf[0]}, nil
+	}
+	return Frame{L: v.buf[0], R: v.buf[1]}, nil
+}
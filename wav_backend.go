@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// WavBackend writes PCM audio to w as a canonical RIFF/WAVE file, patching
+// the header's size fields on Close once the total length is known. This is
+// what enables offline rendering: tests can drive a SampleReader through it
+// and compare the resulting bytes without touching an audio device.
+type WavBackend struct {
+	w io.WriteSeeker
+
+	channels   int
+	bitDepth   int
+	sampleRate int
+	dataLen    uint32
+}
+
+func NewWavBackend(w io.WriteSeeker) *WavBackend {
+	return &WavBackend{w: w}
+}
+
+func (b *WavBackend) Open(sampleRate, channels, bitDepth int) error {
+	b.sampleRate = sampleRate
+	b.channels = channels
+	b.bitDepth = bitDepth
+	return b.writeHeader()
+}
+
+func (b *WavBackend) writeHeader() error {
+	blockAlign := b.channels * b.bitDepth
+	byteRate := b.sampleRate * blockAlign
+
+	header := make([]byte, 44)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], 36+b.dataLen)
+	copy(header[8:12], "WAVE")
+	copy(header[12:16], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:20], 16)
+	binary.LittleEndian.PutUint16(header[20:22], 1) // PCM
+	binary.LittleEndian.PutUint16(header[22:24], uint16(b.channels))
+	binary.LittleEndian.PutUint32(header[24:28], uint32(b.sampleRate))
+	binary.LittleEndian.PutUint32(header[28:32], uint32(byteRate))
+	binary.LittleEndian.PutUint16(header[32:34], uint16(blockAlign))
+	binary.LittleEndian.PutUint16(header[34:36], uint16(b.bitDepth*8))
+	copy(header[36:40], "data")
+	binary.LittleEndian.PutUint32(header[40:44], b.dataLen)
+
+	_, err := b.w.Write(header)
+	return err
+}
+
+func (b *WavBackend) Write(p []byte) (int, error) {
+	n, err := b.w.Write(p)
+	b.dataLen += uint32(n)
+	return n, err
+}
+
+func (b *WavBackend) Close() error {
+	if _, err := b.w.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return b.writeHeader()
+}
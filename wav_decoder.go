@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// rawWavReader reads linear PCM samples straight out of a WAV data chunk at
+// the file's native sample rate and channel count.
+type rawWavReader struct {
+	r        io.Reader
+	channels int
+	bitDepth int
+}
+
+func (w *rawWavReader) Read() (Frame, error) {
+	l, err := w.readSample()
+	if err != nil {
+		return Frame{}, err
+	}
+
+	if w.channels == 1 {
+		return Frame{L: l, R: l}, nil
+	}
+
+	r, err := w.readSample()
+	if err != nil {
+		return Frame{}, err
+	}
+
+	// Any channels beyond stereo are discarded.
+	for i := 2; i < w.channels; i++ {
+		if _, err := w.readSample(); err != nil {
+			return Frame{}, err
+		}
+	}
+
+	return Frame{L: l, R: r}, nil
+}
+
+func (w *rawWavReader) readSample() (float32, error) {
+	switch w.bitDepth {
+	case 8:
+		var v uint8
+		if err := binary.Read(w.r, binary.LittleEndian, &v); err != nil {
+			return 0, wavEOF(err)
+		}
+		return (float32(v) - 128) / 128, nil
+	case 16:
+		var v int16
+		if err := binary.Read(w.r, binary.LittleEndian, &v); err != nil {
+			return 0, wavEOF(err)
+		}
+		return float32(v) / 0x8000, nil
+	case 32:
+		var v int32
+		if err := binary.Read(w.r, binary.LittleEndian, &v); err != nil {
+			return 0, wavEOF(err)
+		}
+		return float32(v) / 0x80000000, nil
+	default:
+		return 0, fmt.Errorf("wav: unsupported bit depth %d", w.bitDepth)
+	}
+}
+
+func wavEOF(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrEndOfSamples
+	}
+	return err
+}
+
+// NewWavDecoder parses a RIFF/WAVE file from r and returns a SampleReader
+// resampled to match contextSampleRate.
+func NewWavDecoder(r io.Reader, contextSampleRate int) (SampleReader, error) {
+	var riffHeader [12]byte
+	if _, err := io.ReadFull(r, riffHeader[:]); err != nil {
+		return nil, fmt.Errorf("wav: read header: %w", err)
+	}
+	if string(riffHeader[0:4]) != "RIFF" || string(riffHeader[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("wav: not a RIFF/WAVE file")
+	}
+
+	var channels, bitDepth, sampleRate int
+	for {
+		var chunkHeader [8]byte
+		if _, err := io.ReadFull(r, chunkHeader[:]); err != nil {
+			return nil, fmt.Errorf("wav: read chunk header: %w", err)
+		}
+		id := string(chunkHeader[0:4])
+		size := binary.LittleEndian.Uint32(chunkHeader[4:8])
+
+		switch id {
+		case "fmt ":
+			fmtBody := make([]byte, size)
+			if _, err := io.ReadFull(r, fmtBody); err != nil {
+				return nil, fmt.Errorf("wav: read fmt chunk: %w", err)
+			}
+			channels = int(binary.LittleEndian.Uint16(fmtBody[2:4]))
+			sampleRate = int(binary.LittleEndian.Uint32(fmtBody[4:8]))
+			bitDepth = int(binary.LittleEndian.Uint16(fmtBody[14:16]))
+			if err := skipRiffPad(r, size); err != nil {
+				return nil, fmt.Errorf("wav: skip fmt chunk pad: %w", err)
+			}
+		case "data":
+			raw := &rawWavReader{r: io.LimitReader(r, int64(size)), channels: channels, bitDepth: bitDepth}
+			return NewResampler(raw, sampleRate, contextSampleRate), nil
+		default:
+			if _, err := io.CopyN(io.Discard, r, int64(size)); err != nil {
+				return nil, fmt.Errorf("wav: skip chunk %q: %w", id, err)
+			}
+			if err := skipRiffPad(r, size); err != nil {
+				return nil, fmt.Errorf("wav: skip chunk %q pad: %w", id, err)
+			}
+		}
+	}
+}
+
+// skipRiffPad consumes the single pad byte the RIFF format requires after
+// any chunk whose size is odd, so the following chunk header stays aligned.
+func skipRiffPad(r io.Reader, size uint32) error {
+	if size%2 == 0 {
+		return nil
+	}
+	var pad [1]byte
+	_, err := io.ReadFull(r, pad[:])
+	return err
+}